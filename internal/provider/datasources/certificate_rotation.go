@@ -0,0 +1,107 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/cachefly/cachefly-go-sdk/pkg/cachefly"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/models"
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/rotation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificateRotationDataSource{}
+
+func NewCertificateRotationDataSource() datasource.DataSource {
+	return &CertificateRotationDataSource{}
+}
+
+// CertificateRotationDataSource reports the in-memory rotation state built up
+// by cachefly_certificate resources' own Update calls during this provider
+// invocation. It is read-only: as a data source it must never itself cause a
+// rotation (Create/Delete/BindToService), so it only ever reads the
+// manager's bookkeeping, never checks a certificate against the API.
+type CertificateRotationDataSource struct {
+	client *cachefly.Client
+}
+
+func (d *CertificateRotationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_rotation"
+}
+
+func (d *CertificateRotationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the state of the provider's automatic certificate rotation manager for this run: when certificates were last rotated, when the next check is due, and the rotation history. Rotation itself happens only during apply, in each cachefly_certificate resource's own Update; this data source never triggers it.",
+
+		Attributes: map[string]schema.Attribute{
+			"last_rotated_at": schema.StringAttribute{
+				Description: "Timestamp of the most recent rotation performed by the manager, if any.",
+				Computed:    true,
+			},
+			"next_check_at": schema.StringAttribute{
+				Description: "Timestamp of the next scheduled expiry check.",
+				Computed:    true,
+			},
+			"history": schema.ListNestedAttribute{
+				Description: "Rotations performed by the manager so far, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"old_certificate_id": schema.StringAttribute{
+							Description: "ID of the certificate that was replaced.",
+							Computed:    true,
+						},
+						"new_certificate_id": schema.StringAttribute{
+							Description: "ID of the certificate that replaced it.",
+							Computed:    true,
+						},
+						"rotated_at": schema.StringAttribute{
+							Description: "When the rotation completed.",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Why the rotation was triggered (\"expired\" or \"expiring\").",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CertificateRotationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cachefly.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cachefly.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CertificateRotationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.CertificateRotationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manager := rotation.ForClient(d.client)
+
+	data.FromRotationState(ctx, manager.NextCheck(), manager.History())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,362 @@
+// internal/provider/datasources/certificates.go
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cachefly/cachefly-go-sdk/pkg/cachefly"
+	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+// certificateAttrTypes is the object type of each element in the
+// certificates list, shared between schema and state construction.
+var certificateAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"subject_common_name": types.StringType,
+	"subject_names":       types.SetType{ElemType: types.StringType},
+	"domains":             types.SetType{ElemType: types.StringType},
+	"services":            types.SetType{ElemType: types.StringType},
+	"expired":             types.BoolType,
+	"expiring":            types.BoolType,
+	"in_use":              types.BoolType,
+	"managed":             types.BoolType,
+	"not_before":          types.StringType,
+	"not_after":           types.StringType,
+	"created_at":          types.StringType,
+}
+
+// CertificatesDataSource defines the data source implementation.
+type CertificatesDataSource struct {
+	client *cachefly.Client
+}
+
+func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CacheFly Certificates data source. List all certificates on the account, with optional filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"expiring_within_days": schema.Int64Attribute{
+				Description: "Only return certificates whose not_after falls within this many days from now.",
+				Optional:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Only return certificates currently bound to services (true) or not (false).",
+				Optional:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Only return CacheFly-managed certificates (true) or customer-uploaded certificates (false).",
+				Optional:    true,
+			},
+			"domain_contains": schema.StringAttribute{
+				Description: "Only return certificates that have at least one domain containing this substring.",
+				Optional:    true,
+			},
+			"subject_common_name": schema.StringAttribute{
+				Description: "Only return certificates whose subject common name matches exactly.",
+				Optional:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Offset applied to the result list (default: 0). If any filter argument is set, this applies to the filtered results; otherwise it is passed straight through to the API's account-wide list.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Limit applied to the result list (default: API default). If any filter argument is set, this applies to the filtered results; otherwise it is passed straight through to the API's account-wide list.",
+				Optional:    true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "List of certificates matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the certificate.",
+							Computed:    true,
+						},
+						"subject_common_name": schema.StringAttribute{
+							Description: "The common name (CN) from the certificate's subject.",
+							Computed:    true,
+						},
+						"subject_names": schema.SetAttribute{
+							Description: "All subject names from the certificate (including CN and SAN).",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"domains": schema.SetAttribute{
+							Description: "List of domains covered by this certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"services": schema.SetAttribute{
+							Description: "List of service IDs using this certificate.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Whether the certificate has expired.",
+							Computed:    true,
+						},
+						"expiring": schema.BoolAttribute{
+							Description: "Whether the certificate is expiring soon.",
+							Computed:    true,
+						},
+						"in_use": schema.BoolAttribute{
+							Description: "Whether the certificate is currently in use by services.",
+							Computed:    true,
+						},
+						"managed": schema.BoolAttribute{
+							Description: "Whether this is a CacheFly-managed certificate.",
+							Computed:    true,
+						},
+						"not_before": schema.StringAttribute{
+							Description: "Certificate validity start date (ISO 8601 format).",
+							Computed:    true,
+						},
+						"not_after": schema.StringAttribute{
+							Description: "Certificate validity end date (ISO 8601 format).",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the certificate was uploaded to CacheFly.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cachefly.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cachefly.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.CertificatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading certificates data source", map[string]interface{}{
+		"expiring_within_days": data.ExpiringWithinDays.ValueInt64(),
+		"domain_contains":      data.DomainContains.ValueString(),
+	})
+
+	var matched []api.Certificate
+
+	if hasAnyFilter(data) {
+		// limit/offset must apply to the filtered result set, not to
+		// whatever page the filters happen to be evaluated against, so walk
+		// every certificate on the account before slicing.
+		all, err := d.fetchAllCertificates(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading CacheFly Certificates",
+				"Could not read certificates: "+err.Error(),
+			)
+			return
+		}
+
+		for _, cert := range all {
+			if matchesFilters(cert, data) {
+				matched = append(matched, cert)
+			}
+		}
+		matched = paginate(matched, data.Offset, data.Limit)
+	} else {
+		opts := api.ListCertificatesOptions{}
+		if !data.Offset.IsNull() {
+			opts.Offset = int(data.Offset.ValueInt64())
+		}
+		if !data.Limit.IsNull() {
+			opts.Limit = int(data.Limit.ValueInt64())
+		}
+
+		certsResp, err := d.client.Certificates.List(ctx, opts)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading CacheFly Certificates",
+				"Could not read certificates: "+err.Error(),
+			)
+			return
+		}
+		matched = certsResp.Certificates
+	}
+
+	certs := make([]attr.Value, 0, len(matched))
+	for _, cert := range matched {
+		subjectNames, _ := types.SetValueFrom(ctx, types.StringType, cert.SubjectNames)
+		domains, _ := types.SetValueFrom(ctx, types.StringType, cert.Domains)
+		services, _ := types.SetValueFrom(ctx, types.StringType, cert.Services)
+
+		certObj, diags := types.ObjectValue(certificateAttrTypes, map[string]attr.Value{
+			"id":                  types.StringValue(cert.ID),
+			"subject_common_name": types.StringValue(cert.SubjectCommonName),
+			"subject_names":       subjectNames,
+			"domains":             domains,
+			"services":            services,
+			"expired":             types.BoolValue(cert.Expired),
+			"expiring":            types.BoolValue(cert.Expiring),
+			"in_use":              types.BoolValue(cert.InUse),
+			"managed":             types.BoolValue(cert.Managed),
+			"not_before":          types.StringValue(cert.NotBefore),
+			"not_after":           types.StringValue(cert.NotAfter),
+			"created_at":          types.StringValue(cert.CreatedAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		certs = append(certs, certObj)
+	}
+
+	certList, diags := types.ListValue(types.ObjectType{AttrTypes: certificateAttrTypes}, certs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Certificates = certList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchAllCertificatesPageSize is the page size used to walk the full
+// account-wide certificate list when client-side filters are in play.
+const fetchAllCertificatesPageSize = 100
+
+// fetchAllCertificates walks every certificate on the account, ignoring the
+// caller's offset/limit, so that filtering can happen over the complete list
+// instead of whatever single page the API would otherwise return.
+func (d *CertificatesDataSource) fetchAllCertificates(ctx context.Context) ([]api.Certificate, error) {
+	var all []api.Certificate
+
+	offset := 0
+	for {
+		page, err := d.client.Certificates.List(ctx, api.ListCertificatesOptions{
+			Offset: offset,
+			Limit:  fetchAllCertificatesPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Certificates...)
+		if len(page.Certificates) < fetchAllCertificatesPageSize {
+			return all, nil
+		}
+		offset += fetchAllCertificatesPageSize
+	}
+}
+
+// paginate applies offset/limit to an already-filtered certificate list.
+func paginate(certs []api.Certificate, offset, limit types.Int64) []api.Certificate {
+	start := 0
+	if !offset.IsNull() {
+		start = int(offset.ValueInt64())
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > len(certs) {
+		start = len(certs)
+	}
+	certs = certs[start:]
+
+	if !limit.IsNull() {
+		if n := int(limit.ValueInt64()); n >= 0 && n < len(certs) {
+			certs = certs[:n]
+		}
+	}
+
+	return certs
+}
+
+// hasAnyFilter reports whether any client-side filter argument is set.
+func hasAnyFilter(data models.CertificatesDataSourceModel) bool {
+	return !data.ExpiringWithinDays.IsNull() ||
+		!data.InUse.IsNull() ||
+		!data.Managed.IsNull() ||
+		!data.DomainContains.IsNull() ||
+		!data.SubjectCommonName.IsNull()
+}
+
+// matchesFilters applies the optional filter arguments client-side, since the
+// CacheFly list API does not support them directly.
+func matchesFilters(cert api.Certificate, data models.CertificatesDataSourceModel) bool {
+	if !data.InUse.IsNull() && cert.InUse != data.InUse.ValueBool() {
+		return false
+	}
+
+	if !data.Managed.IsNull() && cert.Managed != data.Managed.ValueBool() {
+		return false
+	}
+
+	if !data.SubjectCommonName.IsNull() && cert.SubjectCommonName != data.SubjectCommonName.ValueString() {
+		return false
+	}
+
+	if !data.DomainContains.IsNull() {
+		substr := data.DomainContains.ValueString()
+		found := false
+		for _, domain := range cert.Domains {
+			if strings.Contains(domain, substr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !data.ExpiringWithinDays.IsNull() {
+		notAfter, err := time.Parse(time.RFC3339, cert.NotAfter)
+		if err != nil {
+			return false
+		}
+		cutoff := time.Now().AddDate(0, 0, int(data.ExpiringWithinDays.ValueInt64()))
+		if notAfter.After(cutoff) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	timeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/rotation"
+)
+
+// AutoRotateModel describes the `auto_rotate` nested block on
+// CertificateModel, configuring automatic replacement of an expiring
+// certificate.
+type AutoRotateModel struct {
+	RotationSource    types.String `tfsdk:"rotation_source"`
+	CheckInterval     types.String `tfsdk:"check_interval"`
+	Command           types.String `tfsdk:"command"`
+	ACMEEmail         types.String `tfsdk:"acme_email"`
+	ACMEDirectoryURL  types.String `tfsdk:"acme_directory_url"`
+	ACMEChallengeType types.String `tfsdk:"acme_challenge_type"`
+	ACMEDNSCommand    types.String `tfsdk:"acme_dns_command"`
+}
+
+// ToRotationWatch converts the auto_rotate block into the rotation manager's
+// Watch configuration for the given certificate.
+func (a *AutoRotateModel) ToRotationWatch(certID string) rotation.Watch {
+	checkInterval := rotation.DefaultCheckInterval
+	if v := a.CheckInterval.ValueString(); v != "" {
+		if parsed, err := time.ParseDuration(v); err == nil {
+			checkInterval = parsed
+		}
+	}
+
+	return rotation.Watch{
+		CertificateID:     certID,
+		Source:            rotation.Source(a.RotationSource.ValueString()),
+		CheckInterval:     checkInterval,
+		Command:           a.Command.ValueString(),
+		ACMEEmail:         a.ACMEEmail.ValueString(),
+		ACMEDirectoryURL:  a.ACMEDirectoryURL.ValueString(),
+		ACMEChallengeType: a.ACMEChallengeType.ValueString(),
+		ACMEDNSCommand:    a.ACMEDNSCommand.ValueString(),
+	}
+}
+
+// CertificateModel describes the Terraform resource data model for an
+// uploaded (customer-supplied PEM) certificate.
+type CertificateModel struct {
+	ID             types.String     `tfsdk:"id"`
+	Certificate    types.String     `tfsdk:"certificate"`
+	CertificateKey types.String     `tfsdk:"certificate_key"`
+	Password       types.String     `tfsdk:"password"`
+	Name           types.String     `tfsdk:"name"`
+	NamePrefix     types.String     `tfsdk:"name_prefix"`
+	Timeouts       timeouts.Value   `tfsdk:"timeouts"`
+	AutoRotate     *AutoRotateModel `tfsdk:"auto_rotate"`
+
+	// Computed attributes from the API
+	SubjectCommonName types.String `tfsdk:"subject_common_name"`
+	SubjectNames      types.Set    `tfsdk:"subject_names"`
+	Expired           types.Bool   `tfsdk:"expired"`
+	Expiring          types.Bool   `tfsdk:"expiring"`
+	InUse             types.Bool   `tfsdk:"in_use"`
+	Managed           types.Bool   `tfsdk:"managed"`
+	Services          types.Set    `tfsdk:"services"`
+	Domains           types.Set    `tfsdk:"domains"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	RotationPending   types.Bool   `tfsdk:"rotation_pending"`
+}
+
+// ToSDKCreateRequest converts the Terraform model into the SDK request used
+// to upload a certificate.
+func (m *CertificateModel) ToSDKCreateRequest(ctx context.Context) *api.CreateCertificateRequest {
+	return &api.CreateCertificateRequest{
+		Certificate:    m.Certificate.ValueString(),
+		CertificateKey: m.CertificateKey.ValueString(),
+		Password:       m.Password.ValueString(),
+		Name:           m.Name.ValueString(),
+	}
+}
+
+// FromSDKCertificate maps an SDK Certificate response onto the Terraform model.
+func (m *CertificateModel) FromSDKCertificate(ctx context.Context, cert *api.Certificate) {
+	m.ID = types.StringValue(cert.ID)
+	m.Name = types.StringValue(cert.Name)
+	m.SubjectCommonName = types.StringValue(cert.SubjectCommonName)
+	m.Expired = types.BoolValue(cert.Expired)
+	m.Expiring = types.BoolValue(cert.Expiring)
+	m.InUse = types.BoolValue(cert.InUse)
+	m.Managed = types.BoolValue(cert.Managed)
+	m.NotBefore = types.StringValue(cert.NotBefore)
+	m.NotAfter = types.StringValue(cert.NotAfter)
+	m.CreatedAt = types.StringValue(cert.CreatedAt)
+
+	subjectNames, _ := types.SetValueFrom(ctx, types.StringType, cert.SubjectNames)
+	m.SubjectNames = subjectNames
+
+	services, _ := types.SetValueFrom(ctx, types.StringType, cert.Services)
+	m.Services = services
+
+	domains, _ := types.SetValueFrom(ctx, types.StringType, cert.Domains)
+	m.Domains = domains
+}
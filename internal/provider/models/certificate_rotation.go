@@ -0,0 +1,59 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/rotation"
+)
+
+// CertificateRotationDataSourceModel describes the Terraform data model for
+// the cachefly_certificate_rotation data source, which surfaces the state of
+// the provider's rotation manager as of this invocation.
+type CertificateRotationDataSourceModel struct {
+	LastRotatedAt types.String `tfsdk:"last_rotated_at"`
+	NextCheckAt   types.String `tfsdk:"next_check_at"`
+	History       types.List   `tfsdk:"history"`
+}
+
+// historyRecordAttrTypes is the object type of each element in History.
+var historyRecordAttrTypes = map[string]attr.Type{
+	"old_certificate_id": types.StringType,
+	"new_certificate_id": types.StringType,
+	"rotated_at":         types.StringType,
+	"reason":             types.StringType,
+}
+
+// FromRotationState maps the rotation manager's current state onto the data
+// source model. A zero next check time means no certificate is currently
+// watched, and is reported as null rather than a formatted zero-value date.
+func (m *CertificateRotationDataSourceModel) FromRotationState(ctx context.Context, next time.Time, records []rotation.Record) {
+	if next.IsZero() {
+		m.NextCheckAt = types.StringNull()
+	} else {
+		m.NextCheckAt = types.StringValue(next.Format("2006-01-02T15:04:05Z07:00"))
+	}
+
+	if len(records) == 0 {
+		m.LastRotatedAt = types.StringNull()
+	} else {
+		m.LastRotatedAt = types.StringValue(records[0].RotatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	}
+
+	elems := make([]attr.Value, len(records))
+	for i, rec := range records {
+		obj, _ := types.ObjectValue(historyRecordAttrTypes, map[string]attr.Value{
+			"old_certificate_id": types.StringValue(rec.OldCertificateID),
+			"new_certificate_id": types.StringValue(rec.NewCertificateID),
+			"rotated_at":         types.StringValue(rec.RotatedAt.Format("2006-01-02T15:04:05Z07:00")),
+			"reason":             types.StringValue(rec.Reason),
+		})
+		elems[i] = obj
+	}
+
+	history, _ := types.ListValue(types.ObjectType{AttrTypes: historyRecordAttrTypes}, elems)
+	m.History = history
+}
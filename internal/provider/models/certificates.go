@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CertificatesDataSourceModel describes the Terraform data model for the
+// cachefly_certificates listing data source.
+type CertificatesDataSourceModel struct {
+	ExpiringWithinDays types.Int64  `tfsdk:"expiring_within_days"`
+	InUse              types.Bool   `tfsdk:"in_use"`
+	Managed            types.Bool   `tfsdk:"managed"`
+	DomainContains     types.String `tfsdk:"domain_contains"`
+	SubjectCommonName  types.String `tfsdk:"subject_common_name"`
+	Offset             types.Int64  `tfsdk:"offset"`
+	Limit              types.Int64  `tfsdk:"limit"`
+
+	Certificates types.List `tfsdk:"certificates"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
+)
+
+// ManagedCertificateModel describes the Terraform resource data model for a
+// CacheFly-managed (auto-issued) certificate.
+type ManagedCertificateModel struct {
+	ID            types.String `tfsdk:"id"`
+	Domains       types.Set    `tfsdk:"domains"`
+	ChallengeType types.String `tfsdk:"challenge_type"`
+	KeyAlgorithm  types.String `tfsdk:"key_algorithm"`
+
+	// Computed attributes from the API
+	SubjectCommonName types.String `tfsdk:"subject_common_name"`
+	SubjectNames      types.Set    `tfsdk:"subject_names"`
+	Expired           types.Bool   `tfsdk:"expired"`
+	Expiring          types.Bool   `tfsdk:"expiring"`
+	InUse             types.Bool   `tfsdk:"in_use"`
+	Managed           types.Bool   `tfsdk:"managed"`
+	Services          types.Set    `tfsdk:"services"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+// ToSDKCreateManagedRequest converts the Terraform model into the SDK request
+// used to ask CacheFly to issue and maintain a certificate for the given domains.
+func (m *ManagedCertificateModel) ToSDKCreateManagedRequest(ctx context.Context) *api.CreateManagedCertificateRequest {
+	var domains []string
+	m.Domains.ElementsAs(ctx, &domains, false)
+
+	return &api.CreateManagedCertificateRequest{
+		Domains:       domains,
+		ChallengeType: m.ChallengeType.ValueString(),
+		KeyAlgorithm:  m.KeyAlgorithm.ValueString(),
+	}
+}
+
+// FromSDKCertificate maps an SDK Certificate response onto the Terraform
+// model. It deliberately leaves Domains untouched: domains is a Required
+// (non-Computed) attribute, so overwriting it with the API's echoed value
+// would violate the plan/state consistency contract if the server ever
+// normalizes or supplements the list the caller sent.
+func (m *ManagedCertificateModel) FromSDKCertificate(ctx context.Context, cert *api.Certificate) {
+	m.ID = types.StringValue(cert.ID)
+	m.SubjectCommonName = types.StringValue(cert.SubjectCommonName)
+	m.Expired = types.BoolValue(cert.Expired)
+	m.Expiring = types.BoolValue(cert.Expiring)
+	m.InUse = types.BoolValue(cert.InUse)
+	m.Managed = types.BoolValue(cert.Managed)
+	m.NotBefore = types.StringValue(cert.NotBefore)
+	m.NotAfter = types.StringValue(cert.NotAfter)
+	m.CreatedAt = types.StringValue(cert.CreatedAt)
+
+	subjectNames, _ := types.SetValueFrom(ctx, types.StringType, cert.SubjectNames)
+	m.SubjectNames = subjectNames
+
+	services, _ := types.SetValueFrom(ctx, types.StringType, cert.Services)
+	m.Services = services
+}
@@ -0,0 +1,102 @@
+// internal/provider/provider.go
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cachefly/cachefly-go-sdk/pkg/cachefly"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/datasources"
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/resources"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ provider.Provider = &CacheflyProvider{}
+
+// CacheflyProvider defines the provider implementation.
+type CacheflyProvider struct {
+	// version is set by the build process from the release tag, and
+	// reported to Terraform as the provider version in Metadata.
+	version string
+}
+
+// CacheflyProviderModel describes the provider-level configuration block.
+type CacheflyProviderModel struct {
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+// New is a helper function to simplify provider server instantiation.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &CacheflyProvider{version: version}
+	}
+}
+
+func (p *CacheflyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "cachefly"
+	resp.Version = p.version
+}
+
+func (p *CacheflyProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Interact with the CacheFly CDN API.",
+
+		Attributes: map[string]schema.Attribute{
+			"api_token": schema.StringAttribute{
+				Description: "CacheFly API token. May also be set via the CACHEFLY_API_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure builds the shared CacheFly API client and hands it to every
+// resource and data source's own Configure via ProviderData.
+func (p *CacheflyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data CacheflyProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := data.APIToken.ValueString()
+	if token == "" {
+		token = os.Getenv("CACHEFLY_API_TOKEN")
+	}
+	if token == "" {
+		resp.Diagnostics.AddError(
+			"Missing CacheFly API Token",
+			"The provider cannot create the CacheFly API client without an api_token. Set the api_token attribute or the CACHEFLY_API_TOKEN environment variable.",
+		)
+		return
+	}
+
+	client := cachefly.NewClient(token)
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *CacheflyProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		resources.NewCertificateResource,
+		resources.NewManagedCertificateResource,
+	}
+}
+
+func (p *CacheflyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		datasources.NewOriginsDataSource,
+		datasources.NewCertificatesDataSource,
+		datasources.NewCertificateRotationDataSource,
+	}
+}
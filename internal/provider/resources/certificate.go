@@ -2,13 +2,20 @@ package resources
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"time"
 
+	timeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -16,12 +23,23 @@ import (
 	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
 
 	"github.com/cachefly/terraform-provider-cachefly/internal/provider/models"
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/rotation"
 )
 
+// defaultCertificateTimeout is used for the create/read timeouts block when
+// the practitioner does not set one explicitly.
+const defaultCertificateTimeout = 5 * time.Minute
+
+// initialPollInterval is the delay after the first (immediate) poll while
+// waiting for a certificate to reach a usable state; it backs off
+// exponentially on each subsequent attempt.
+const initialPollInterval = 20 * time.Second
+
 // Ensure provider defined types fully satisfy framework interfaces
 var (
 	_ resource.Resource                = &CertificateResource{}
 	_ resource.ResourceWithImportState = &CertificateResource{}
+	_ resource.ResourceWithModifyPlan  = &CertificateResource{}
 )
 
 // NewCertificateResource is a helper function to simplify the provider implementation
@@ -31,7 +49,8 @@ func NewCertificateResource() resource.Resource {
 
 // CertificateResource defines the resource implementation
 type CertificateResource struct {
-	client *cachefly.Client
+	client          *cachefly.Client
+	rotationManager *rotation.Manager
 }
 
 // Metadata returns the resource type name
@@ -76,6 +95,28 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"name": schema.StringAttribute{
+				Description: "Unique name for the certificate. Conflicts with name_prefix. If neither is set, CacheFly assigns a name. Combine name_prefix with `lifecycle { create_before_destroy = true }` to swap certificates without a window where no certificate exists. Changing this value replaces the certificate, since certificates cannot be renamed in place.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("name_prefix")),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Prefix used to generate a unique certificate name, with a random suffix appended. Conflicts with name. Changing this value replaces the certificate, since the generated name is only ever computed once, on create.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("name")),
+				},
+			},
 			// Computed attributes from the API
 			"subject_common_name": schema.StringAttribute{
 				Description: "The common name (CN) from the certificate's subject.",
@@ -124,6 +165,53 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Timestamp when the certificate was uploaded to CacheFly.",
 				Computed:    true,
 			},
+			"rotation_pending": schema.BoolAttribute{
+				Description: "Whether auto_rotate has found this certificate expired or expiring and a replacement is due. The provider sets this to unknown during planning to produce an apply-time diff, and performs the actual rotation in Update once that plan is approved; it is not meant to be set in config.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+			"auto_rotate": schema.SingleNestedBlock{
+				Description: "Automatically replace this certificate when it is reported expired or expiring. When set, the provider's rotation manager drives issuance via the configured rotation_source and re-binds any services currently using this certificate to the replacement.",
+				Attributes: map[string]schema.Attribute{
+					"rotation_source": schema.StringAttribute{
+						Description: "Where replacement certificate material comes from: \"acme\" to drive an ACME client using this certificate's domains, or \"command\" to run a user-provided command that prints PEM + key on stdout.",
+						Required:    true,
+					},
+					"check_interval": schema.StringAttribute{
+						Description: "How often to check this certificate for expiry, as a Go duration string (e.g. \"1h\"). Defaults to 1 hour.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("1h"),
+					},
+					"command": schema.StringAttribute{
+						Description: "Shell command to run when rotation_source is \"command\". Must print PEM-encoded certificate and key blocks on stdout.",
+						Optional:    true,
+					},
+					"acme_email": schema.StringAttribute{
+						Description: "Contact email used to register the ACME account when rotation_source is \"acme\".",
+						Optional:    true,
+					},
+					"acme_directory_url": schema.StringAttribute{
+						Description: "ACME directory URL to use when rotation_source is \"acme\" (e.g. Let's Encrypt's production or staging directory).",
+						Optional:    true,
+					},
+					"acme_challenge_type": schema.StringAttribute{
+						Description: "ACME challenge type to use when rotation_source is \"acme\": \"dns-01\" (default, requires acme_dns_command) publishes a TXT record via a user-provided command, which works regardless of where rotation runs. \"http-01\" instead binds a listener on :80 of the host running terraform, which only validates when that host is the one actually serving the domain -- fine for a single-host demo, not for most real deployments.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("dns-01"),
+					},
+					"acme_dns_command": schema.StringAttribute{
+						Description: "Shell command run to publish the DNS-01 TXT record when acme_challenge_type is \"dns-01\". Invoked with the record name to create in $CACHEFLY_ACME_DNS_NAME and the value to publish in $CACHEFLY_ACME_DNS_VALUE; must not return until the record is visible to the ACME server (e.g. after waiting for propagation).",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -144,6 +232,7 @@ func (r *CertificateResource) Configure(ctx context.Context, req resource.Config
 	}
 
 	r.client = client
+	r.rotationManager = rotation.ForClient(client)
 }
 
 // Create creates the resource and sets the initial Terraform state
@@ -174,8 +263,28 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCertificateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err = r.waitForValidation(ctx, cert.ID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting For CacheFly Certificate Validation",
+			err.Error(),
+		)
+		return
+	}
+
 	// Map response to state, preserving sensitive input data
 	r.mapCertificateToState(cert, &data)
+	data.RotationPending = types.BoolValue(false)
+
+	if data.AutoRotate != nil {
+		r.rotationManager.Watch(data.AutoRotate.ToRotationWatch(cert.ID))
+	}
 
 	tflog.Debug(ctx, "Certificate created successfully", map[string]interface{}{
 		"certificate_id":      cert.ID,
@@ -201,7 +310,20 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		"certificate_id": certID,
 	})
 
-	cert, err := r.client.Certificates.GetByID(ctx, certID, "")
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultCertificateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Unlike Create, Read must not wait for validation: an expired or
+	// expiring certificate is a normal, already-modeled state (see the
+	// expired/expiring computed attributes) and must simply be reported,
+	// not retried until a fresh upload validates.
+	cert, err := r.client.Certificates.GetByID(readCtx, certID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading CacheFly Certificate",
@@ -223,24 +345,76 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 	data.CertificateKey = existingCertificateKey
 	data.Password = existingPassword
 
+	// Read only registers bookkeeping here; it must never rotate anything
+	// itself, since Read runs during a plain `terraform plan` with no
+	// apply and no user-approved diff. ModifyPlan below does the (read-only)
+	// due check, and Update performs the actual rotation once a practitioner
+	// has approved the resulting plan.
+	if data.AutoRotate != nil {
+		r.rotationManager.Watch(data.AutoRotate.ToRotationWatch(certID))
+	} else {
+		r.rotationManager.Unwatch(certID)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update updates the resource - certificates are immutable, so this mainly handles drift
+// Update handles the in-place changes this resource supports: the
+// auto_rotate block being added, changed, or removed, auto_rotate actually
+// rotating a certificate it found due during ModifyPlan, and changes to the
+// timeouts block. All other configurable attributes carry RequiresReplace
+// and never reach Update.
 func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data models.CertificateModel
+	var plan models.CertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var state models.CertificateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Certificates are immutable in CacheFly - any change requires replacement
-	// This should not be called due to RequiresReplace plan modifiers
-	resp.Diagnostics.AddError(
-		"Certificate Update Not Supported",
-		"Certificates cannot be updated. Any changes to certificate content require replacement.",
-	)
+	certID := state.ID.ValueString()
+
+	var cert *api.Certificate
+	var err error
+
+	if plan.AutoRotate == nil {
+		r.rotationManager.Unwatch(certID)
+		cert, err = r.client.Certificates.GetByID(ctx, certID, "")
+	} else {
+		watch := plan.AutoRotate.ToRotationWatch(certID)
+		r.rotationManager.Watch(watch)
+
+		var rotated bool
+		cert, rotated, err = r.rotationManager.Rotate(ctx, certID, watch)
+		if err == nil && rotated {
+			tflog.Info(ctx, "rotated certificate during apply", map[string]interface{}{
+				"old_certificate_id": certID,
+				"new_certificate_id": cert.ID,
+			})
+		}
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating CacheFly Certificate",
+			"Could not update certificate "+certID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.Certificate = state.Certificate
+	plan.CertificateKey = state.CertificateKey
+	plan.Password = state.Password
+
+	r.mapCertificateToState(cert, &plan)
+	plan.RotationPending = types.BoolValue(false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete deletes the resource
@@ -258,6 +432,8 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		"certificate_id": certID,
 	})
 
+	r.rotationManager.Unwatch(certID)
+
 	// Delete certificate via API
 	err := r.client.Certificates.Delete(ctx, certID)
 	if err != nil {
@@ -286,7 +462,123 @@ func (r *CertificateResource) ImportState(ctx context.Context, req resource.Impo
 	)
 }
 
+// ModifyPlan computes a unique name from name_prefix during planning, so
+// that two certificate instances can coexist under
+// lifecycle { create_before_destroy = true } without both requesting the
+// same generated name. It also performs the read-only auto_rotate due check,
+// flipping rotation_pending to unknown so a due rotation shows up as a plan
+// diff instead of happening silently; Update is what actually rotates, once
+// that diff is approved.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destroy plan, nothing to compute.
+		return
+	}
+
+	var plan models.CertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := false
+
+	if !plan.NamePrefix.IsNull() && plan.Name.IsUnknown() {
+		suffix, err := randomSuffix()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Generating Certificate Name",
+				"Could not generate a random suffix for name_prefix: "+err.Error(),
+			)
+			return
+		}
+
+		plan.Name = types.StringValue(plan.NamePrefix.ValueString() + suffix)
+		changed = true
+	}
+
+	// The due check only applies to an existing resource being planned for
+	// update, never to a not-yet-created one, and only once a client is
+	// actually configured.
+	if !req.State.Raw.IsNull() && r.rotationManager != nil && plan.AutoRotate != nil {
+		var state models.CertificateModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		certID := state.ID.ValueString()
+		due, err := r.rotationManager.IsDue(ctx, certID, plan.AutoRotate.ToRotationWatch(certID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Checking CacheFly Certificate Rotation",
+				"Could not check whether certificate "+certID+" is due for rotation: "+err.Error(),
+			)
+			return
+		}
+
+		if due {
+			plan.RotationPending = types.BoolUnknown()
+			changed = true
+		}
+	}
+
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+// randomSuffix returns an 8-character hex suffix suitable for appending to
+// name_prefix.
+func randomSuffix() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // Helper function to map SDK Certificate to Terraform state
 func (r *CertificateResource) mapCertificateToState(cert *api.Certificate, data *models.CertificateModel) {
 	data.FromSDKCertificate(context.Background(), cert)
 }
+
+// waitForValidation polls Certificates.GetByID until a freshly uploaded
+// certificate reaches a usable state (not expired, not_after set, and subject
+// names present) or the timeout elapses, backing off exponentially between
+// attempts. The last API response is formatted into the timeout error so
+// callers can surface it for debugging stuck uploads. Only Create needs this: Read must report whatever state the
+// certificate is actually in, including naturally expired.
+func (r *CertificateResource) waitForValidation(ctx context.Context, certID string, timeout time.Duration) (*api.Certificate, error) {
+	deadline := time.Now().Add(timeout)
+	interval := initialPollInterval
+
+	var cert *api.Certificate
+	var err error
+
+	for {
+		cert, err = r.client.Certificates.GetByID(ctx, certID, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not poll certificate %s: %w", certID, err)
+		}
+
+		if !cert.Expired && cert.NotAfter != "" && len(cert.SubjectNames) > 0 {
+			return cert, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return nil, fmt.Errorf(
+				"timed out after %s waiting for certificate %s to validate; last response: %+v",
+				timeout, certID, cert,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context cancelled while waiting for certificate %s to validate: %w", certID, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+	}
+}
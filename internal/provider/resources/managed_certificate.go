@@ -0,0 +1,325 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cachefly/cachefly-go-sdk/pkg/cachefly"
+	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
+
+	"github.com/cachefly/terraform-provider-cachefly/internal/provider/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &ManagedCertificateResource{}
+	_ resource.ResourceWithImportState = &ManagedCertificateResource{}
+)
+
+// NewManagedCertificateResource is a helper function to simplify the provider implementation
+func NewManagedCertificateResource() resource.Resource {
+	return &ManagedCertificateResource{}
+}
+
+// ManagedCertificateResource defines the resource implementation
+type ManagedCertificateResource struct {
+	client *cachefly.Client
+}
+
+// Metadata returns the resource type name
+func (r *ManagedCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_certificate"
+}
+
+// Schema defines the schema for the resource
+func (r *ManagedCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CacheFly Managed Certificate resource. Requests CacheFly to issue and maintain a certificate for a list of domains, without requiring the caller to supply PEM material.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.SetAttribute{
+				Description: "Domains CacheFly should issue and maintain the managed certificate for.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"challenge_type": schema.StringAttribute{
+				Description: "Domain validation challenge type used to issue the certificate (e.g. 'http-01', 'dns-01'). Defaults to 'http-01'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("http-01"),
+			},
+			"key_algorithm": schema.StringAttribute{
+				Description: "Key algorithm to use for the issued certificate (e.g. 'rsa-2048', 'ecdsa-p256'). Defaults to 'rsa-2048'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("rsa-2048"),
+			},
+			// Computed attributes from the API
+			"subject_common_name": schema.StringAttribute{
+				Description: "The common name (CN) from the certificate's subject.",
+				Computed:    true,
+			},
+			"subject_names": schema.SetAttribute{
+				Description: "All subject names from the certificate (including CN and SAN).",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether the certificate has expired.",
+				Computed:    true,
+			},
+			"expiring": schema.BoolAttribute{
+				Description: "Whether the certificate is expiring soon.",
+				Computed:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Whether the certificate is currently in use by services.",
+				Computed:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Whether this is a CacheFly-managed certificate. Always true for this resource.",
+				Computed:    true,
+			},
+			"services": schema.SetAttribute{
+				Description: "List of service IDs using this certificate.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"not_before": schema.StringAttribute{
+				Description: "Certificate validity start date (ISO 8601 format).",
+				Computed:    true,
+			},
+			"not_after": schema.StringAttribute{
+				Description: "Certificate validity end date (ISO 8601 format).",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the certificate was issued.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *ManagedCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cachefly.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cachefly.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *ManagedCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.ManagedCertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := data.ToSDKCreateManagedRequest(ctx)
+
+	tflog.Debug(ctx, "Requesting CacheFly managed certificate", map[string]interface{}{
+		"domains":        createReq.Domains,
+		"challenge_type": createReq.ChallengeType,
+		"key_algorithm":  createReq.KeyAlgorithm,
+	})
+
+	cert, err := r.client.Certificates.CreateManaged(ctx, *createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Requesting CacheFly Managed Certificate",
+			"Could not request managed certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	cert, err = r.waitForIssuance(ctx, cert.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting For Managed Certificate Issuance",
+			err.Error(),
+		)
+		return
+	}
+
+	data.FromSDKCertificate(ctx, cert)
+
+	tflog.Debug(ctx, "Managed certificate issued successfully", map[string]interface{}{
+		"certificate_id":      cert.ID,
+		"subject_common_name": cert.SubjectCommonName,
+		"expires":             cert.NotAfter,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *ManagedCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data models.ManagedCertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certID := data.ID.ValueString()
+
+	tflog.Debug(ctx, "Reading managed certificate", map[string]interface{}{
+		"certificate_id": certID,
+	})
+
+	cert, err := r.client.Certificates.GetByID(ctx, certID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading CacheFly Managed Certificate",
+			"Could not read certificate with ID "+certID+": "+err.Error(),
+		)
+		return
+	}
+
+	data.FromSDKCertificate(ctx, cert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update requests a renewal of the managed certificate. Since the domain list
+// and issuance parameters can change in place, and an approaching expiry
+// should refresh the certificate rather than force a replacement, this is not
+// gated behind RequiresReplace plan modifiers.
+func (r *ManagedCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.ManagedCertificateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certID := data.ID.ValueString()
+	updateReq := data.ToSDKCreateManagedRequest(ctx)
+
+	tflog.Debug(ctx, "Refreshing managed certificate", map[string]interface{}{
+		"certificate_id": certID,
+		"domains":        updateReq.Domains,
+	})
+
+	cert, err := r.client.Certificates.RenewManaged(ctx, certID, *updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Refreshing CacheFly Managed Certificate",
+			"Could not refresh managed certificate with ID "+certID+": "+err.Error(),
+		)
+		return
+	}
+
+	cert, err = r.waitForIssuance(ctx, cert.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting For Managed Certificate Issuance",
+			err.Error(),
+		)
+		return
+	}
+
+	data.FromSDKCertificate(ctx, cert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource
+func (r *ManagedCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data models.ManagedCertificateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certID := data.ID.ValueString()
+
+	tflog.Debug(ctx, "Deleting managed certificate", map[string]interface{}{
+		"certificate_id": certID,
+	})
+
+	err := r.client.Certificates.Delete(ctx, certID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting CacheFly Managed Certificate",
+			"Could not delete certificate with ID "+certID+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Managed certificate deleted successfully", map[string]interface{}{
+		"certificate_id": certID,
+	})
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *ManagedCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForIssuance polls the API until the managed certificate reaches a
+// usable, issued state (a populated NotAfter date), giving up after a fixed
+// number of attempts.
+func (r *ManagedCertificateResource) waitForIssuance(ctx context.Context, certID string) (*api.Certificate, error) {
+	const (
+		maxAttempts = 15
+		pollEvery   = 20 * time.Second
+	)
+
+	var cert *api.Certificate
+	var err error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		cert, err = r.client.Certificates.GetByID(ctx, certID, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not poll certificate %s for issuance: %w", certID, err)
+		}
+
+		if cert.NotAfter != "" {
+			return cert, nil
+		}
+
+		if attempt == maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context cancelled while waiting for certificate %s to be issued: %w", certID, ctx.Err())
+		case <-time.After(pollEvery):
+		}
+	}
+
+	return nil, fmt.Errorf("timed out waiting for certificate %s to finish issuance", certID)
+}
@@ -0,0 +1,204 @@
+package rotation
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+// issueACME drives an ACME client (e.g. Let's Encrypt) through an order for
+// the given domains and returns PEM-encoded certificate and key material
+// suitable for Certificates.Create. challengeType selects how each
+// authorization is validated: "dns-01" (the default) runs dnsCommand to
+// publish a TXT record, which works no matter where this rotation actually
+// runs; "http-01" binds a local :80 listener instead, which only validates
+// when this process happens to be running on the host serving the domain.
+func issueACME(ctx context.Context, domains []string, email, directoryURL, challengeType, dnsCommand string) (certPEM, keyPEM string, err error) {
+	if len(domains) == 0 {
+		return "", "", fmt.Errorf("acme rotation requires at least one domain from the existing certificate")
+	}
+
+	if challengeType == "" {
+		challengeType = "dns-01"
+	}
+
+	accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("could not generate ACME account key: %w", err)
+	}
+
+	client := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: directoryURL,
+	}
+
+	if _, err := client.Register(ctx, &acme.Account{Contact: []string{"mailto:" + email}}, acme.AcceptTOS); err != nil {
+		return "", "", fmt.Errorf("could not register ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return "", "", fmt.Errorf("could not authorize ACME order: %w", err)
+	}
+
+	for i, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return "", "", fmt.Errorf("could not fetch ACME authorization: %w", err)
+		}
+
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == challengeType {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return "", "", fmt.Errorf("no %s challenge offered for authorization %s", challengeType, authzURL)
+		}
+
+		var stopChallengeServer func()
+		switch challengeType {
+		case "dns-01":
+			if err := publishDNS01Record(ctx, client, challenge, domains[i], dnsCommand); err != nil {
+				return "", "", fmt.Errorf("could not publish dns-01 challenge record for authorization %s: %w", authzURL, err)
+			}
+		case "http-01":
+			stopChallengeServer, err = serveHTTP01Challenge(client, challenge)
+			if err != nil {
+				return "", "", fmt.Errorf("could not serve http-01 challenge response for authorization %s: %w", authzURL, err)
+			}
+		default:
+			return "", "", fmt.Errorf("unknown acme_challenge_type %q", challengeType)
+		}
+
+		if _, err := client.Accept(ctx, challenge); err != nil {
+			if stopChallengeServer != nil {
+				stopChallengeServer()
+			}
+			return "", "", fmt.Errorf("could not accept ACME challenge for authorization %s: %w", authzURL, err)
+		}
+
+		_, err = client.WaitAuthorization(ctx, authzURL)
+		if stopChallengeServer != nil {
+			stopChallengeServer()
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("ACME authorization %s did not complete: %w", authzURL, err)
+		}
+	}
+
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("could not generate certificate key: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: domains,
+	}, certKey)
+	if err != nil {
+		return "", "", fmt.Errorf("could not create certificate request: %w", err)
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return "", "", fmt.Errorf("ACME order %s did not become ready: %w", order.URI, err)
+	}
+
+	derChain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return "", "", fmt.Errorf("could not finalize ACME order: %w", err)
+	}
+
+	var certBuf []byte
+	for _, der := range derChain {
+		certBuf = append(certBuf, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(certKey)
+	if err != nil {
+		return "", "", fmt.Errorf("could not marshal certificate key: %w", err)
+	}
+	keyBuf := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certBuf), string(keyBuf), nil
+}
+
+// serveHTTP01Challenge computes the key authorization for challenge and
+// serves it at its well-known HTTP-01 path on :80, the same approach used by
+// golang.org/x/crypto/acme/autocert. The machine running this rotation must
+// be reachable on port 80 for the domain under validation; the returned stop
+// function must be called once the authorization has been accepted to tear
+// the listener back down.
+func serveHTTP01Challenge(client *acme.Client, challenge *acme.Challenge) (stop func(), err error) {
+	response, err := client.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute http-01 challenge response: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.HTTP01ChallengePath(challenge.Token), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, response)
+	})
+
+	ln, err := net.Listen("tcp", ":80")
+	if err != nil {
+		return nil, fmt.Errorf("could not bind :80 to serve the http-01 challenge response: %w", err)
+	}
+
+	srv := &http.Server{Handler: mux}
+	go srv.Serve(ln)
+
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}, nil
+}
+
+// publishDNS01Record runs a user-provided shell command to publish the
+// dns-01 TXT record for challenge, passing the record name and value the
+// ACME server expects as environment variables. The command must not return
+// until the record is visible to the ACME server, e.g. after waiting out DNS
+// propagation; dnsCommand is what actually makes rotation work regardless of
+// which host runs terraform, unlike serveHTTP01Challenge above.
+func publishDNS01Record(ctx context.Context, client *acme.Client, challenge *acme.Challenge, domain, dnsCommand string) error {
+	if dnsCommand == "" {
+		return fmt.Errorf("acme_challenge_type \"dns-01\" requires acme_dns_command to be set")
+	}
+
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return fmt.Errorf("could not compute dns-01 challenge record for %s: %w", domain, err)
+	}
+	name := "_acme-challenge." + domain
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", dnsCommand)
+	cmd.Env = append(os.Environ(),
+		"CACHEFLY_ACME_DNS_NAME="+name,
+		"CACHEFLY_ACME_DNS_VALUE="+value,
+	)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("dns-01 publish command failed: %w (stderr: %s)", err, stderr.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package rotation
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"os/exec"
+)
+
+// issueCommand runs a user-provided shell command expected to print
+// PEM-encoded certificate and key blocks (in either order) on stdout, and
+// splits them apart for Certificates.Create.
+func issueCommand(ctx context.Context, command string) (certPEM, keyPEM string, err error) {
+	if command == "" {
+		return "", "", fmt.Errorf("rotation_source \"command\" requires the command attribute to be set")
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("rotation command failed: %w (stderr: %s)", err, stderr.String())
+	}
+
+	rest := stdout.Bytes()
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			certPEM += string(pem.EncodeToMemory(block))
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			keyPEM += string(pem.EncodeToMemory(block))
+		}
+	}
+
+	if certPEM == "" || keyPEM == "" {
+		return "", "", fmt.Errorf("rotation command output did not contain both a certificate and a private key PEM block")
+	}
+
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,270 @@
+// Package rotation implements certificate rotation for the
+// cachefly_certificate resource. It watches certificates that have opted
+// into an `auto_rotate` block and, once one is found expired or expiring,
+// drives the configured rotation source (ACME or an external command) to
+// obtain replacement material, uploads it, re-binds any dependent services,
+// and retires the old certificate.
+//
+// Rotation must only happen at apply time, with a plan a practitioner has
+// approved: Create/Delete/BindToService calls are not something a plain
+// `terraform plan` or a data source read is allowed to trigger out of band.
+// IsDue is a read-only check safe to call from ModifyPlan (it never mutates
+// anything), and is used to flip a computed attribute to unknown so that a
+// due rotation actually shows up as a plan diff. Rotate performs the
+// mutation and is only ever called from the certificate resource's own
+// Update, for its own certificate -- never as a sweep across every watch,
+// so rotating one resource can never reach into another's state.
+package rotation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cachefly/cachefly-go-sdk/pkg/cachefly"
+	api "github.com/cachefly/cachefly-go-sdk/pkg/cachefly/api/v2_5"
+)
+
+// Source identifies where replacement certificate material comes from.
+type Source string
+
+const (
+	// SourceACME drives an ACME client using the domains of the existing certificate.
+	SourceACME Source = "acme"
+	// SourceCommand shells out to a user-provided command that prints PEM + key on stdout.
+	SourceCommand Source = "command"
+)
+
+// DefaultCheckInterval is used when a watch does not specify one.
+const DefaultCheckInterval = time.Hour
+
+// Watch describes how a single certificate should be kept fresh.
+type Watch struct {
+	CertificateID     string
+	Source            Source
+	CheckInterval     time.Duration
+	Command           string
+	ACMEEmail         string
+	ACMEDirectoryURL  string
+	ACMEChallengeType string
+	ACMEDNSCommand    string
+}
+
+// Record is one completed rotation, surfaced through the
+// cachefly_certificate_rotation data source.
+type Record struct {
+	OldCertificateID string
+	NewCertificateID string
+	RotatedAt        time.Time
+	Reason           string
+}
+
+// Manager tracks watched certificates and their rotation history. It holds
+// no state that is expected to survive past the current provider
+// invocation; each invocation rebuilds its watch list from resource state
+// as resources are planned and read.
+type Manager struct {
+	client *cachefly.Client
+
+	mu          sync.Mutex
+	watches     map[string]Watch
+	lastChecked map[string]time.Time
+	history     []Record
+}
+
+var (
+	managersMu sync.Mutex
+	managers   = map[*cachefly.Client]*Manager{}
+)
+
+// ForClient returns the process-wide rotation manager for the given client,
+// creating it on first use. Resources and data sources that need to watch or
+// inspect rotation state should call this instead of constructing a Manager
+// directly, so that a single manager is shared across all resource instances
+// configured with the same client for the lifetime of this provider
+// invocation.
+func ForClient(client *cachefly.Client) *Manager {
+	managersMu.Lock()
+	defer managersMu.Unlock()
+
+	if m, ok := managers[client]; ok {
+		return m
+	}
+
+	m := newManager(client)
+	managers[client] = m
+	return m
+}
+
+func newManager(client *cachefly.Client) *Manager {
+	return &Manager{
+		client:      client,
+		watches:     map[string]Watch{},
+		lastChecked: map[string]time.Time{},
+	}
+}
+
+// Watch registers (or replaces) the rotation policy for a certificate. It
+// only updates bookkeeping; it never reads or mutates the certificate
+// itself.
+func (m *Manager) Watch(w Watch) {
+	if w.CheckInterval <= 0 {
+		w.CheckInterval = DefaultCheckInterval
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.watches[w.CertificateID] = w
+}
+
+// Unwatch removes a certificate from rotation management, e.g. on destroy or
+// when auto_rotate is removed from config.
+func (m *Manager) Unwatch(certID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.watches, certID)
+	delete(m.lastChecked, certID)
+}
+
+// History returns completed rotations, most recent first.
+func (m *Manager) History() []Record {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Record, len(m.history))
+	for i, r := range m.history {
+		out[len(out)-1-i] = r
+	}
+	return out
+}
+
+// NextCheck returns when the manager will next consider a watched
+// certificate due for a check, based on each watch's CheckInterval and when
+// it was last actually checked. It returns the zero Time if nothing is
+// watched yet. It is a pure read of in-memory bookkeeping and never calls
+// the API.
+func (m *Manager) NextCheck() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var next time.Time
+	for certID, w := range m.watches {
+		due := m.lastChecked[certID].Add(w.CheckInterval)
+		if next.IsZero() || due.Before(next) {
+			next = due
+		}
+	}
+	return next
+}
+
+// IsDue reports whether the given certificate is currently expired or
+// expiring. It only reads the certificate (GetByID) and never rotates it, so
+// it is safe to call from ModifyPlan: a practitioner must still approve the
+// resulting plan diff before Rotate runs. Checks are throttled by
+// w.CheckInterval against the last time this certificate was checked,
+// whether by IsDue or Rotate.
+func (m *Manager) IsDue(ctx context.Context, certID string, w Watch) (bool, error) {
+	m.mu.Lock()
+	last, checked := m.lastChecked[certID]
+	m.mu.Unlock()
+
+	if checked && time.Since(last) < w.CheckInterval {
+		return false, nil
+	}
+
+	cert, err := m.client.Certificates.GetByID(ctx, certID, "")
+	if err != nil {
+		return false, fmt.Errorf("could not read certificate %s: %w", certID, err)
+	}
+
+	m.mu.Lock()
+	m.lastChecked[certID] = time.Now()
+	m.mu.Unlock()
+
+	return cert.Expired || cert.Expiring, nil
+}
+
+// Rotate checks the given certificate and, if it is expired or expiring,
+// issues and uploads a replacement, re-binds any services using it, and
+// retires the old certificate. It acts only on the one certificate it is
+// given -- it never reaches into any other watch -- so it is safe to call
+// from that certificate's own resource Update. It returns the certificate to
+// store in state: the existing one if nothing was due, the replacement
+// otherwise.
+func (m *Manager) Rotate(ctx context.Context, certID string, w Watch) (cert *api.Certificate, rotated bool, err error) {
+	cert, err = m.client.Certificates.GetByID(ctx, certID, "")
+	if err != nil {
+		return nil, false, fmt.Errorf("could not read certificate %s: %w", certID, err)
+	}
+
+	m.mu.Lock()
+	m.lastChecked[certID] = time.Now()
+	m.mu.Unlock()
+
+	if !cert.Expired && !cert.Expiring {
+		return cert, false, nil
+	}
+
+	reason := "expiring"
+	if cert.Expired {
+		reason = "expired"
+	}
+
+	certPEM, keyPEM, err := m.issue(ctx, w, cert)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not obtain replacement material for certificate %s: %w", certID, err)
+	}
+
+	newCert, err := m.client.Certificates.Create(ctx, api.CreateCertificateRequest{
+		Certificate:    certPEM,
+		CertificateKey: keyPEM,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("could not upload rotated certificate for %s: %w", certID, err)
+	}
+
+	for _, serviceID := range cert.Services {
+		if err := m.client.Certificates.BindToService(ctx, newCert.ID, serviceID); err != nil {
+			return nil, false, fmt.Errorf("could not re-bind service %s to rotated certificate %s: %w", serviceID, newCert.ID, err)
+		}
+	}
+
+	if err := m.client.Certificates.Delete(ctx, certID); err != nil {
+		return nil, false, fmt.Errorf("could not delete superseded certificate %s: %w", certID, err)
+	}
+
+	m.mu.Lock()
+	delete(m.watches, certID)
+	delete(m.lastChecked, certID)
+	w.CertificateID = newCert.ID
+	m.watches[newCert.ID] = w
+	m.history = append(m.history, Record{
+		OldCertificateID: certID,
+		NewCertificateID: newCert.ID,
+		RotatedAt:        time.Now(),
+		Reason:           reason,
+	})
+	m.mu.Unlock()
+
+	tflog.Info(ctx, "rotated certificate", map[string]interface{}{
+		"old_certificate_id": certID,
+		"new_certificate_id": newCert.ID,
+		"reason":             reason,
+	})
+
+	return newCert, true, nil
+}
+
+func (m *Manager) issue(ctx context.Context, w Watch, cert *api.Certificate) (certPEM, keyPEM string, err error) {
+	switch w.Source {
+	case SourceACME:
+		return issueACME(ctx, cert.Domains, w.ACMEEmail, w.ACMEDirectoryURL, w.ACMEChallengeType, w.ACMEDNSCommand)
+	case SourceCommand:
+		return issueCommand(ctx, w.Command)
+	default:
+		return "", "", fmt.Errorf("unknown rotation source %q", w.Source)
+	}
+}